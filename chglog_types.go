@@ -18,6 +18,14 @@ type Options struct {
 	// Paths restricts `git log` to commits touching one of these paths.
 	Paths []string
 
+	// Backend selects the commit-parsing implementation: "" (default)
+	// uses the exec-based `git log`/`git diff-tree` pipeline; "gogit"
+	// walks the repository in-process via go-git.
+	Backend string
+
+	// NoCache disables the on-disk commit cache (`--no-cache`).
+	NoCache bool
+
 	HeaderPattern     string
 	HeaderPatternMaps []string
 
@@ -46,6 +54,19 @@ type Options struct {
 	JiraTypeMaps                map[string]string
 	JiraIssueDescriptionPattern string
 
+	// IssueTrackers maps an IssuePrefix (e.g. "GH-", "!", "LP#") to the
+	// tracker instance that should be queried for issues matching that
+	// prefix. Nil or empty disables remote issue-tracker enrichment.
+	IssueTrackers map[string]IssueTrackerClient
+
+	// VersionCalculator configures the `next-version` bump logic. A
+	// nil value falls back to versionCalculator's defaults.
+	VersionCalculator *VersionCalculatorOptions
+
+	// Components lists the monorepo components to extract
+	// independently when non-empty.
+	Components []*Component
+
 	// Processor, if set, is run over every parsed commit before it is
 	// handed to commitExtractor.Extract; returning nil drops the
 	// commit.
@@ -151,11 +172,21 @@ type Commit struct {
 	CoAuthors []Contact
 	Signers   []Contact
 
+	// Breaking is set when the header carries a conventional-commits
+	// "!" breaking-change marker (e.g. "feat!:", "feat(scope)!:"),
+	// detected once at parse time so downstream consumers (such as
+	// versionCalculator) don't need to re-scan the raw header.
+	Breaking bool
+
 	SubCommits   []*Commit
 	ChangedFiles []string
 
 	JiraIssueID string
 	JiraIssue   *JiraIssue
+
+	// Issues holds the GitHub/GitLab/Launchpad/... issues resolved
+	// for every IssuePrefix-matching ref found in this commit.
+	Issues []*Issue
 }
 
 // JiraIssue is the normalized view of a Jira issue attached to a commit.
@@ -0,0 +1,9 @@
+package chglog
+
+import "path/filepath"
+
+// RunCacheClear removes the on-disk commit cache for the repository at
+// repoPath, backing the `chglog cache clear` CLI subcommand.
+func RunCacheClear(repoPath string) error {
+	return newCommitCache(filepath.Join(repoPath, ".git"), 0).Clear()
+}
@@ -0,0 +1,8 @@
+package chglog
+
+// RunNextVersion computes the next tag for commits since currentTag and
+// is the library entry point for the `chglog next-version` CLI
+// subcommand, which just prints the result.
+func RunNextVersion(config *Config, commits []*Commit, currentTag string) (string, error) {
+	return ComputeNextTag(commits, currentTag, config.Options.VersionCalculator)
+}
@@ -0,0 +1,102 @@
+package chglog
+
+import (
+	"encoding/gob"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// cacheDirName is the subdirectory of .git where parsed commits are
+// cached, keyed by Hash.Long.
+const cacheDirName = "chglog-cache"
+
+// commitCache is a content-addressable, on-disk cache of *Commit values
+// keyed by commit hash, so repeated runs (CI, doc previews) don't
+// reparse commits whose hash hasn't changed.
+type commitCache struct {
+	dir           string
+	schemaVersion uint32
+}
+
+// cacheEntry is what's gob-encoded to disk. SchemaVersion lets entries
+// written under an older HeaderPattern/NoteKeywords/RefActions/
+// IssuePrefix/parser layout be detected and ignored instead of
+// misread. It's kept as the full fnv32 sum rather than truncated to a
+// byte, since truncating left a 1-in-256 chance of two meaningfully
+// different configs hashing to the same schema and silently sharing a
+// stale cache.
+type cacheEntry struct {
+	SchemaVersion uint32
+	Commit        *Commit
+}
+
+// newCommitCache returns a cache rooted at <gitDir>/chglog-cache.
+func newCommitCache(gitDir string, schemaVersion uint32) *commitCache {
+	return &commitCache{
+		dir:           filepath.Join(gitDir, cacheDirName),
+		schemaVersion: schemaVersion,
+	}
+}
+
+// cacheSchemaVersion derives a schema version from everything that can
+// change how a commit is parsed, so stale entries are invalidated
+// automatically instead of silently reused.
+func cacheSchemaVersion(opts *Options) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(opts.HeaderPattern))
+	h.Write([]byte(strings.Join(opts.NoteKeywords, ",")))
+	h.Write([]byte(strings.Join(opts.RefActions, ",")))
+	h.Write([]byte(strings.Join(opts.IssuePrefix, ",")))
+	return h.Sum32()
+}
+
+// Get returns the cached *Commit for hash, if any entry exists and was
+// written under the current schema version.
+func (c *commitCache) Get(hash string) (*Commit, bool) {
+	f, err := os.Open(c.path(hash))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+
+	var entry cacheEntry
+	if err := gob.NewDecoder(f).Decode(&entry); err != nil {
+		return nil, false
+	}
+
+	if entry.SchemaVersion != c.schemaVersion {
+		return nil, false
+	}
+
+	return entry.Commit, true
+}
+
+// Put writes commit to the cache under hash.
+func (c *commitCache) Put(hash string, commit *Commit) error {
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(c.path(hash))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	entry := cacheEntry{
+		SchemaVersion: c.schemaVersion,
+		Commit:        commit,
+	}
+	return gob.NewEncoder(f).Encode(&entry)
+}
+
+// Clear removes the entire cache directory, backing `chglog cache clear`.
+func (c *commitCache) Clear() error {
+	return os.RemoveAll(c.dir)
+}
+
+func (c *commitCache) path(hash string) string {
+	return filepath.Join(c.dir, hash+".gob")
+}
@@ -0,0 +1,68 @@
+package chglog
+
+import "testing"
+
+func TestCommitCacheGetPutRoundtrip(t *testing.T) {
+	cache := newCommitCache(t.TempDir(), cacheSchemaVersion(&Options{HeaderPattern: "a"}))
+
+	commit := &Commit{Hash: &Hash{Long: "abc123", Short: "abc"}, Header: "feat: thing"}
+	if err := cache.Put(commit.Hash.Long, commit); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, ok := cache.Get(commit.Hash.Long)
+	if !ok {
+		t.Fatalf("Get() ok = false, want true")
+	}
+	if got.Header != commit.Header {
+		t.Errorf("Get() Header = %q, want %q", got.Header, commit.Header)
+	}
+}
+
+func TestCommitCacheGetMiss(t *testing.T) {
+	cache := newCommitCache(t.TempDir(), cacheSchemaVersion(&Options{}))
+
+	if _, ok := cache.Get("does-not-exist"); ok {
+		t.Errorf("Get() ok = true for an uncached hash, want false")
+	}
+}
+
+func TestCommitCacheSchemaVersionInvalidatesStaleEntries(t *testing.T) {
+	dir := t.TempDir()
+
+	writer := newCommitCache(dir, cacheSchemaVersion(&Options{HeaderPattern: "a"}))
+	commit := &Commit{Hash: &Hash{Long: "abc123"}}
+	if err := writer.Put(commit.Hash.Long, commit); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	reader := newCommitCache(dir, cacheSchemaVersion(&Options{HeaderPattern: "b"}))
+	if _, ok := reader.Get(commit.Hash.Long); ok {
+		t.Errorf("Get() ok = true for an entry written under a different schema version, want false")
+	}
+}
+
+func TestCacheSchemaVersionDiffersByConfig(t *testing.T) {
+	base := cacheSchemaVersion(&Options{HeaderPattern: "a", NoteKeywords: []string{"BREAKING"}})
+	other := cacheSchemaVersion(&Options{HeaderPattern: "a", NoteKeywords: []string{"NOTE"}})
+
+	if base == other {
+		t.Errorf("cacheSchemaVersion() produced the same value for different NoteKeywords")
+	}
+}
+
+func TestCommitCacheClear(t *testing.T) {
+	cache := newCommitCache(t.TempDir(), cacheSchemaVersion(&Options{}))
+	commit := &Commit{Hash: &Hash{Long: "abc123"}}
+	if err := cache.Put(commit.Hash.Long, commit); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	if err := cache.Clear(); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+
+	if _, ok := cache.Get(commit.Hash.Long); ok {
+		t.Errorf("Get() ok = true after Clear(), want false")
+	}
+}
@@ -19,6 +19,7 @@ var (
 	hashField      = "HASH"
 	authorField    = "AUTHOR"
 	committerField = "COMMITTER"
+	parentField    = "PARENT"
 	subjectField   = "SUBJECT"
 	bodyField      = "BODY"
 
@@ -26,6 +27,7 @@ var (
 	hashFormat      = hashField + ":%H\t%h"
 	authorFormat    = authorField + ":%an\t%ae\t%at"
 	committerFormat = committerField + ":%cn\t%ce\t%ct"
+	parentFormat    = parentField + ":%P"
 	subjectFormat   = subjectField + ":%s"
 	bodyFormat      = bodyField + ":%b"
 
@@ -34,6 +36,7 @@ var (
 		hashFormat,
 		authorFormat,
 		committerFormat,
+		parentFormat,
 		subjectFormat,
 		bodyFormat,
 	}, delimiter)
@@ -51,6 +54,7 @@ type commitParser struct {
 	logger                 *Logger
 	client                 gitcmd.Client
 	jiraClient             JiraClient
+	issueTrackers          map[string]IssueTrackerClient
 	config                 *Config
 	reHeader               *regexp.Regexp
 	reMerge                *regexp.Regexp
@@ -62,19 +66,33 @@ type commitParser struct {
 	reSignOff              *regexp.Regexp
 	reCoAuthor             *regexp.Regexp
 	reJiraIssueDescription *regexp.Regexp
+	reIssueTrackerPrefix   map[string]*regexp.Regexp
+	issueCache             map[string]*Issue
+	cache                  *commitCache
 }
 
-func newCommitParser(logger *Logger, client gitcmd.Client, jiraClient JiraClient, config *Config) *commitParser {
+// newCommitParser builds a commitParser. issueTrackers maps an
+// IssuePrefix (e.g. "GH-", "!", "LP#") to the tracker instance that
+// should be queried for issues matching that prefix; it may be nil or
+// empty when no remote issue-tracker integration is configured. cache
+// may be nil to disable on-disk caching of parsed commits (--no-cache).
+func newCommitParser(logger *Logger, client gitcmd.Client, jiraClient JiraClient, issueTrackers map[string]IssueTrackerClient, cache *commitCache, config *Config) *commitParser {
 	opts := config.Options
 
 	joinedRefActions := joinAndQuoteMeta(opts.RefActions, "|")
 	joinedIssuePrefix := joinAndQuoteMeta(opts.IssuePrefix, "|")
 	joinedNoteKeywords := joinAndQuoteMeta(opts.NoteKeywords, "|")
 
+	reIssueTrackerPrefix := make(map[string]*regexp.Regexp, len(issueTrackers))
+	for prefix := range issueTrackers {
+		reIssueTrackerPrefix[prefix] = regexp.MustCompile("(?:" + regexp.QuoteMeta(prefix) + ")(\\d+)")
+	}
+
 	return &commitParser{
 		logger:                 logger,
 		client:                 client,
 		jiraClient:             jiraClient,
+		issueTrackers:          issueTrackers,
 		config:                 config,
 		reHeader:               regexp.MustCompile(opts.HeaderPattern),
 		reMerge:                regexp.MustCompile(opts.MergePattern),
@@ -86,6 +104,9 @@ func newCommitParser(logger *Logger, client gitcmd.Client, jiraClient JiraClient
 		reSignOff:              regexp.MustCompile(`Signed-off-by:\s+([\p{L}\s\-\[\]]+)\s+<([\w+\-\[\].@]+)>`),
 		reCoAuthor:             regexp.MustCompile(`Co-authored-by:\s+([\p{L}\s\-\[\]]+)\s+<([\w+\-\[\].@]+)>`),
 		reJiraIssueDescription: regexp.MustCompile(opts.JiraIssueDescriptionPattern),
+		reIssueTrackerPrefix:   reIssueTrackerPrefix,
+		issueCache:             make(map[string]*Issue),
+		cache:                  cache,
 	}
 }
 
@@ -115,9 +136,35 @@ func (p *commitParser) Parse(rev string) ([]*Commit, error) {
 	commits := make([]*Commit, len(lines))
 
 	for i, line := range lines {
-		commit, err := p.parseCommit(line)
-		if err != nil {
-			return nil, err
+		var commit *Commit
+
+		if p.cache != nil {
+			if long, short := extractHashFromLine(line); long != "" {
+				if cached, ok := p.cache.Get(long); ok {
+					// A cached Short was abbreviated against whatever
+					// range was walked when it was written; git's %h
+					// abbreviation length tracks repo growth, so reuse
+					// the current run's short hash instead of the
+					// cached one to avoid mixing abbreviation lengths
+					// within a single changelog run.
+					cached.Hash.Short = short
+					commit = cached
+				}
+			}
+		}
+
+		if commit == nil {
+			parsed, err := p.parseCommit(line)
+			if err != nil {
+				return nil, err
+			}
+			commit = parsed
+
+			if p.cache != nil && commit != nil {
+				if err := p.cache.Put(commit.Hash.Long, commit); err != nil {
+					p.logger.Error(fmt.Sprintf("Failed to write commit cache for %s: %s\n", commit.Hash.Long, err))
+				}
+			}
 		}
 
 		if processor != nil {
@@ -136,6 +183,7 @@ func (p *commitParser) Parse(rev string) ([]*Commit, error) {
 func (p *commitParser) parseCommit(input string) (*Commit, error) {
 	commit := &Commit{}
 	tokens := strings.Split(input, delimiter)
+	firstParent := ""
 
 	for _, token := range tokens {
 		firstSep := strings.Index(token, ":")
@@ -149,6 +197,10 @@ func (p *commitParser) parseCommit(input string) (*Commit, error) {
 			commit.Author = p.parseAuthor(value)
 		case committerField:
 			commit.Committer = p.parseCommitter(value)
+		case parentField:
+			if fields := strings.Fields(value); len(fields) > 0 {
+				firstParent = fields[0]
+			}
 		case subjectField:
 			p.processHeader(commit, value)
 		case bodyField:
@@ -158,12 +210,23 @@ func (p *commitParser) parseCommit(input string) (*Commit, error) {
 
 	commit.Refs = p.uniqRefs(commit.Refs)
 	commit.Mentions = p.uniqMentions(commit.Mentions)
-
+	commit.Issues = p.uniqIssues(commit.Issues)
+
+	// Diffing explicitly against the first parent (rather than passing
+	// only commit.Hash.Short and letting diff-tree pick parents itself)
+	// also surfaces ChangedFiles for merge/revert commits, which
+	// diff-tree otherwise skips by default without -m. Root commits
+	// have no parent, so they keep the single-rev form, which
+	// diff-tree leaves empty for the same reason.
 	args := []string{
 		"--no-commit-id",
 		"--name-only",
 		"-r",
-		commit.Hash.Short,
+	}
+	if firstParent != "" {
+		args = append(args, firstParent, commit.Hash.Long)
+	} else {
+		args = append(args, commit.Hash.Short)
 	}
 	out, err := p.client.Exec("diff-tree", args...)
 	if err != nil {
@@ -176,6 +239,23 @@ func (p *commitParser) parseCommit(input string) (*Commit, error) {
 	return commit, nil
 }
 
+// extractHashFromLine cheaply pulls the long and short commit hash out
+// of a raw log line without running the full field/diff-tree parse, so
+// Parse can check the cache before paying for a `git diff-tree`
+// subprocess, and so a cache hit can still use this run's short hash.
+func extractHashFromLine(line string) (long, short string) {
+	tokens := strings.Split(line, delimiter)
+	for _, token := range tokens {
+		if strings.HasPrefix(token, hashField+":") {
+			value := strings.TrimSpace(strings.TrimPrefix(token, hashField+":"))
+			if idx := strings.Index(value, "\t"); idx != -1 {
+				return value[:idx], value[idx+1:]
+			}
+		}
+	}
+	return "", ""
+}
+
 func (p *commitParser) parseHash(input string) *Hash {
 	arr := strings.Split(input, "\t")
 
@@ -214,6 +294,7 @@ func (p *commitParser) processHeader(commit *Commit, input string) {
 
 	// header (raw)
 	commit.Header = input
+	commit.Breaking = strings.Contains(input, "!:")
 
 	var res [][]string
 
@@ -247,6 +328,11 @@ func (p *commitParser) processHeader(commit *Commit, input string) {
 	if commit.JiraIssueID != "" {
 		p.processJiraIssue(commit, commit.JiraIssueID)
 	}
+
+	// GitHub / GitLab / Launchpad / ...
+	if len(p.issueTrackers) > 0 {
+		commit.Issues = p.processIssueTrackerRefs(input)
+	}
 }
 
 func (p *commitParser) extractLineMetadata(commit *Commit, line string) bool {
@@ -276,6 +362,13 @@ func (p *commitParser) extractLineMetadata(commit *Commit, line string) bool {
 		commit.Signers = append(commit.Signers, signers...)
 	}
 
+	if len(p.issueTrackers) > 0 {
+		if issues := p.processIssueTrackerRefs(line); len(issues) > 0 {
+			meta = true
+			commit.Issues = append(commit.Issues, issues...)
+		}
+	}
+
 	return meta
 }
 
@@ -477,6 +570,24 @@ func (p *commitParser) uniqMentions(mentions []string) []string {
 	return arr
 }
 
+func (p *commitParser) uniqIssues(issues []*Issue) []*Issue {
+	arr := []*Issue{}
+
+	for _, issue := range issues {
+		exist := false
+		for _, i := range arr {
+			if issue.Prefix == i.Prefix && issue.ID == i.ID {
+				exist = true
+			}
+		}
+		if !exist {
+			arr = append(arr, issue)
+		}
+	}
+
+	return arr
+}
+
 func (p *commitParser) processJiraIssue(commit *Commit, issueID string) {
 	issue, err := p.jiraClient.GetJiraIssue(commit.JiraIssueID)
 	if err != nil {
@@ -499,6 +610,47 @@ func (p *commitParser) processJiraIssue(commit *Commit, issueID string) {
 	}
 }
 
+// processIssueTrackerRefs resolves every ref in input that matches a
+// configured IssuePrefix against its tracker and returns the hydrated
+// issues. Lookups that fail are logged and skipped, mirroring
+// processJiraIssue. Results are memoized in p.issueCache across the
+// whole Parse run, since the same issue is commonly referenced from
+// more than one commit and trackers are rate-limited remote APIs.
+func (p *commitParser) processIssueTrackerRefs(input string) []*Issue {
+	issues := []*Issue{}
+
+	for prefix, re := range p.reIssueTrackerPrefix {
+		tracker := p.issueTrackers[prefix]
+		res := re.FindAllStringSubmatch(input, -1)
+
+		for _, r := range res {
+			id := r[1]
+			cacheKey := prefix + id
+
+			issue, ok := p.issueCache[cacheKey]
+			if !ok {
+				fetched, err := tracker.GetIssue(id)
+				if err != nil {
+					p.logger.Error(fmt.Sprintf("Failed to fetch issue %s%s: %s\n", prefix, id, err))
+					continue
+				}
+				// The configured prefix key is the source of truth for
+				// which tracker resolved this ref; it may differ from
+				// whatever prefix the client itself hardcodes (e.g.
+				// GitLab resolving both "#" issues and "!" merge
+				// requests through the same IssueTrackerClient).
+				fetched.Prefix = prefix
+				p.issueCache[cacheKey] = fetched
+				issue = fetched
+			}
+
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
 var (
 	fenceTypes = []string{
 		"```",
@@ -0,0 +1,99 @@
+package chglog
+
+import "strings"
+
+// Component describes one independently versioned slice of a monorepo:
+// a path filter, its own commit-type mapping, and its own output
+// template/file. commitExtractor.Extract is invoked once per Component,
+// scoped to the commits that touch its Paths, so each Component ends up
+// with its own *CommitGroup/*NoteGroup set and its own tag stream (e.g.
+// "frontend/v1.2.3", "api/v0.4.0").
+type Component struct {
+	Name       string
+	Paths      []string
+	TagPrefix  string
+	Options    *Options
+	Template   string
+	OutputFile string
+}
+
+// ComponentChangelog is the result of extracting one Component's
+// commits, ready to be handed to that Component's Template.
+type ComponentChangelog struct {
+	Component     *Component
+	CommitGroups  []*CommitGroup
+	MergeCommits  []*Commit
+	RevertCommits []*Commit
+	NoteGroups    []*NoteGroup
+}
+
+// ExtractComponents filters commits down to each Component's Paths and
+// runs commitExtractor.Extract once per Component, so templates can
+// render one file per component or a combined report grouped by
+// component via the returned slice (exposed to templates as
+// .Components).
+func ExtractComponents(commits []*Commit, components []*Component) []*ComponentChangelog {
+	changelogs := make([]*ComponentChangelog, 0, len(components))
+
+	for _, component := range components {
+		scoped := filterCommitsByPaths(commits, component.Paths)
+		extractor := newCommitExtractor(component.Options)
+		commitGroups, mergeCommits, revertCommits, noteGroups := extractor.Extract(scoped)
+
+		changelogs = append(changelogs, &ComponentChangelog{
+			Component:     component,
+			CommitGroups:  commitGroups,
+			MergeCommits:  mergeCommits,
+			RevertCommits: revertCommits,
+			NoteGroups:    noteGroups,
+		})
+	}
+
+	return changelogs
+}
+
+// filterCommitsByPaths keeps only commits that changed a file under one
+// of paths, including merge and revert commits: both backends diff
+// every commit (merges and reverts included) against its first parent,
+// so ChangedFiles is populated regardless of commit kind.
+func filterCommitsByPaths(commits []*Commit, paths []string) []*Commit {
+	if len(paths) == 0 {
+		return commits
+	}
+
+	filtered := make([]*Commit, 0, len(commits))
+	for _, commit := range commits {
+		if commitTouchesPaths(commit, paths) {
+			filtered = append(filtered, commit)
+		}
+	}
+	return filtered
+}
+
+// FilterTagsByPrefix keeps only tags belonging to a component's
+// TagPrefix (e.g. "frontend/v1.2.3" for prefix "frontend/"), so tag
+// selection and "since last tag" ranges stay per-component.
+func FilterTagsByPrefix(tags []string, prefix string) []string {
+	if prefix == "" {
+		return tags
+	}
+
+	filtered := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, prefix) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+func commitTouchesPaths(commit *Commit, paths []string) bool {
+	for _, file := range commit.ChangedFiles {
+		for _, path := range paths {
+			if file == path || strings.HasPrefix(file, strings.TrimSuffix(path, "/")+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
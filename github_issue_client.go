@@ -0,0 +1,91 @@
+package chglog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GitHubIssueClient fetches issues from the GitHub REST API and
+// normalizes them into *Issue values.
+type GitHubIssueClient struct {
+	Owner string
+	Repo  string
+	Token string
+
+	httpClient *http.Client
+}
+
+// NewGitHubIssueClient creates a GitHubIssueClient for the given
+// "owner/repo" pair. Token may be empty for public repositories, in
+// which case requests are subject to GitHub's unauthenticated rate
+// limit.
+func NewGitHubIssueClient(owner, repo, token string) *GitHubIssueClient {
+	return &GitHubIssueClient{
+		Owner:      owner,
+		Repo:       repo,
+		Token:      token,
+		httpClient: http.DefaultClient,
+	}
+}
+
+type githubIssue struct {
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	ClosedAt  string `json:"closed_at"`
+	Milestone struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Assignees []struct {
+		Login string `json:"login"`
+	} `json:"assignees"`
+}
+
+func (c *GitHubIssueClient) GetIssue(id string) (*Issue, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/%s/issues/%s", c.Owner, c.Repo, id)
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("github: failed to fetch issue %s: %s", id, resp.Status)
+	}
+
+	var gh githubIssue
+	if err := json.NewDecoder(resp.Body).Decode(&gh); err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		Prefix: "GH-",
+		ID:     id,
+		Title:  gh.Title,
+		State:  gh.State,
+	}
+	issue.ClosedAt = parseRFC3339Timestamp(gh.ClosedAt)
+	issue.Milestone = gh.Milestone.Title
+
+	for _, l := range gh.Labels {
+		issue.Labels = append(issue.Labels, l.Name)
+	}
+	for _, a := range gh.Assignees {
+		issue.Assignees = append(issue.Assignees, a.Login)
+	}
+
+	return issue, nil
+}
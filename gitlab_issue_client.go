@@ -0,0 +1,109 @@
+package chglog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GitLabIssueClient fetches issues or merge requests from the GitLab
+// REST API and normalizes them into *Issue values. Set Kind to
+// "merge_request" to resolve IDs against merge requests instead of
+// issues (useful for mapping a `!` IssuePrefix).
+type GitLabIssueClient struct {
+	BaseURL   string
+	ProjectID string
+	Token     string
+	Kind      string
+
+	httpClient *http.Client
+}
+
+// NewGitLabIssueClient creates a GitLabIssueClient for the given
+// project. baseURL defaults to https://gitlab.com when empty, so
+// self-hosted instances can be pointed at their own API root.
+func NewGitLabIssueClient(baseURL, projectID, token string) *GitLabIssueClient {
+	if baseURL == "" {
+		baseURL = "https://gitlab.com"
+	}
+
+	return &GitLabIssueClient{
+		BaseURL:    baseURL,
+		ProjectID:  projectID,
+		Token:      token,
+		Kind:       "issue",
+		httpClient: http.DefaultClient,
+	}
+}
+
+// NewGitLabMergeRequestClient is NewGitLabIssueClient preconfigured to
+// resolve IDs against merge requests rather than issues.
+func NewGitLabMergeRequestClient(baseURL, projectID, token string) *GitLabIssueClient {
+	c := NewGitLabIssueClient(baseURL, projectID, token)
+	c.Kind = "merge_request"
+	return c
+}
+
+type gitlabIssue struct {
+	Title     string   `json:"title"`
+	State     string   `json:"state"`
+	ClosedAt  string   `json:"closed_at"`
+	Labels    []string `json:"labels"`
+	Assignees []struct {
+		Username string `json:"username"`
+	} `json:"assignees"`
+	Milestone struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+}
+
+func (c *GitLabIssueClient) GetIssue(id string) (*Issue, error) {
+	resource := "issues"
+	prefix := "#"
+	if c.Kind == "merge_request" {
+		resource = "merge_requests"
+		prefix = "!"
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v4/projects/%s/%s/%s", c.BaseURL, url.PathEscape(c.ProjectID), resource, id)
+
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.Token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gitlab: failed to fetch %s %s: %s", resource, id, resp.Status)
+	}
+
+	var gl gitlabIssue
+	if err := json.NewDecoder(resp.Body).Decode(&gl); err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		Prefix:    prefix,
+		ID:        id,
+		Title:     gl.Title,
+		State:     gl.State,
+		Labels:    gl.Labels,
+		Milestone: gl.Milestone.Title,
+	}
+	issue.ClosedAt = parseRFC3339Timestamp(gl.ClosedAt)
+
+	for _, a := range gl.Assignees {
+		issue.Assignees = append(issue.Assignees, a.Username)
+	}
+
+	return issue, nil
+}
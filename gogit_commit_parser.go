@@ -0,0 +1,245 @@
+package chglog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// gogitCommitParser is an alternative to commitParser that walks the
+// repository in-process via go-git instead of shelling out to `git log`
+// and, per commit, `git diff-tree`. It embeds *commitParser to reuse
+// header/body/notes/refs/Jira/issue-tracker processing unchanged, so
+// the two backends produce byte-identical *Commit values.
+type gogitCommitParser struct {
+	*commitParser
+	repo *git.Repository
+}
+
+// newGogitCommitParser opens the repository at repoPath and returns a
+// parser backed by go-git plumbing. It is selected via Config.Options.Backend
+// == "gogit"; the exec-based commitParser remains the default.
+func newGogitCommitParser(logger *Logger, repoPath string, jiraClient JiraClient, issueTrackers map[string]IssueTrackerClient, cache *commitCache, config *Config) (*gogitCommitParser, error) {
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gogitCommitParser{
+		commitParser: newCommitParser(logger, nil, jiraClient, issueTrackers, cache, config),
+		repo:         repo,
+	}, nil
+}
+
+func (p *gogitCommitParser) Parse(rev string) ([]*Commit, error) {
+	hash, err := p.repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := p.repo.Log(&git.LogOptions{
+		From:       *hash,
+		Order:      git.LogOrderCommitterTime,
+		PathFilter: p.pathFilter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	processor := p.config.Options.Processor
+	objects := []*object.Commit{}
+
+	if err := iter.ForEach(func(c *object.Commit) error {
+		objects = append(objects, c)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// `git log --pretty=...%h...` abbreviates each hash to the shortest
+	// prefix that's unambiguous across the walked range (minimum 7).
+	// Computing it once over the whole range, rather than per commit,
+	// keeps this an O(1) extra pass instead of reintroducing a
+	// per-commit subprocess.
+	shortLen := shortHashLength(objects)
+
+	commits := make([]*Commit, 0, len(objects))
+
+	for _, c := range objects {
+		var commit *Commit
+
+		if p.cache != nil {
+			if cached, ok := p.cache.Get(c.Hash.String()); ok {
+				// See the exec backend's cache lookup: a cached Short
+				// was abbreviated against a possibly smaller walked
+				// range, so recompute it against this run's shortLen
+				// rather than trusting the cached value.
+				cached.Hash.Short = c.Hash.String()[:shortLen]
+				commit = cached
+			}
+		}
+
+		if commit == nil {
+			parsed, err := p.parseCommit(c, shortLen)
+			if err != nil {
+				return nil, err
+			}
+			commit = parsed
+
+			if p.cache != nil {
+				if err := p.cache.Put(commit.Hash.Long, commit); err != nil {
+					p.logger.Error(fmt.Sprintf("Failed to write commit cache for %s: %s\n", commit.Hash.Long, err))
+				}
+			}
+		}
+
+		if processor != nil {
+			commit = processor.ProcessCommit(commit)
+			if commit == nil {
+				continue
+			}
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// shortHashLength returns the shortest hex-prefix length (minimum 7,
+// matching git's own floor) that uniquely identifies every commit in
+// objects, approximating the "auto" core.abbrev git uses for %h. Unlike
+// git, this only disambiguates against the walked commit range rather
+// than every object in the repository, but it tracks real repo growth
+// far better than a hardcoded 7.
+func shortHashLength(objects []*object.Commit) int {
+	length := 7
+
+	for ; length < 40; length++ {
+		seen := make(map[string]struct{}, len(objects))
+		collision := false
+
+		for _, c := range objects {
+			prefix := c.Hash.String()[:length]
+			if _, ok := seen[prefix]; ok {
+				collision = true
+				break
+			}
+			seen[prefix] = struct{}{}
+		}
+
+		if !collision {
+			break
+		}
+	}
+
+	return length
+}
+
+// pathFilter returns nil when no path scoping is configured, matching
+// the exec backend's behavior of appending `-- <paths>` only when
+// Options.Paths is non-empty.
+func (p *gogitCommitParser) pathFilter() func(string) bool {
+	paths := p.config.Options.Paths
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return func(name string) bool {
+		for _, path := range paths {
+			if name == path || strings.HasPrefix(name, strings.TrimSuffix(path, "/")+"/") {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (p *gogitCommitParser) parseCommit(c *object.Commit, shortLen int) (*Commit, error) {
+	commit := &Commit{
+		Hash: &Hash{
+			Long:  c.Hash.String(),
+			Short: c.Hash.String()[:shortLen],
+		},
+		Author: &Author{
+			Name:  c.Author.Name,
+			Email: c.Author.Email,
+			Date:  c.Author.When,
+		},
+		Committer: &Committer{
+			Name:  c.Committer.Name,
+			Email: c.Committer.Email,
+			Date:  c.Committer.When,
+		},
+	}
+
+	header, body, _ := strings.Cut(c.Message, "\n")
+	p.processHeader(commit, strings.TrimSpace(header))
+	p.processBody(commit, strings.TrimPrefix(body, "\n"))
+
+	commit.Refs = p.uniqRefs(commit.Refs)
+	commit.Mentions = p.uniqMentions(commit.Mentions)
+	commit.Issues = p.uniqIssues(commit.Issues)
+
+	changedFiles, err := p.changedFiles(c)
+	if err != nil {
+		return nil, err
+	}
+	commit.ChangedFiles = changedFiles
+
+	return commit, nil
+}
+
+// changedFiles mirrors the exec backend's explicit first-parent diff:
+// every commit with at least one parent is diffed against that first
+// parent, which also surfaces a file list for merge/revert commits
+// instead of leaving it empty. Root commits (no parent) yield none.
+func (p *gogitCommitParser) changedFiles(c *object.Commit) ([]string, error) {
+	if c.NumParents() == 0 {
+		return nil, nil
+	}
+
+	parent, err := c.Parent(0)
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree, err := parent.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := c.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	// Tree.Diff uses go-git's DefaultDiffTreeOptions, which detects
+	// renames and folds a renamed file into one Change with both
+	// From/To set. `git diff-tree` without `-M` (what the exec backend
+	// runs) doesn't detect renames and lists the old and new path as
+	// two separate lines, so rename detection is disabled here too to
+	// keep both backends' ChangedFiles identical.
+	changes, err := object.DiffTreeWithOptions(context.Background(), parentTree, tree, &object.DiffTreeOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(changes))
+	for _, change := range changes {
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,26 @@
+package chglog
+
+import "time"
+
+// Issue is a normalized view of a ticket, issue, or merge/pull request
+// fetched from a remote issue tracker, regardless of which tracker it
+// came from.
+type Issue struct {
+	Prefix     string
+	ID         string
+	Title      string
+	Labels     []string
+	Assignees  []string
+	State      string
+	Milestone  string
+	ClosedAt   *time.Time
+	Resolution string
+}
+
+// IssueTrackerClient fetches a single issue by its ID from a remote
+// issue tracker and normalizes it into an *Issue. Implementations wrap
+// the HTTP API of a specific tracker (GitHub, GitLab, Launchpad, Jira,
+// ...).
+type IssueTrackerClient interface {
+	GetIssue(id string) (*Issue, error)
+}
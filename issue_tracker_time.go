@@ -0,0 +1,19 @@
+package chglog
+
+import "time"
+
+// parseRFC3339Timestamp parses an RFC3339 timestamp as returned by the
+// GitHub and GitLab APIs. An empty string (no closed-at date) yields a
+// nil time rather than an error.
+func parseRFC3339Timestamp(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
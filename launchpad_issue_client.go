@@ -0,0 +1,56 @@
+package chglog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LaunchpadIssueClient fetches bugs from the Launchpad API and
+// normalizes them into *Issue values.
+type LaunchpadIssueClient struct {
+	httpClient *http.Client
+}
+
+// NewLaunchpadIssueClient creates a LaunchpadIssueClient. Launchpad bug
+// lookups are unauthenticated and not scoped to a single project, so
+// there is nothing to configure beyond the IssuePrefix mapping (e.g.
+// `LP#`).
+func NewLaunchpadIssueClient() *LaunchpadIssueClient {
+	return &LaunchpadIssueClient{
+		httpClient: http.DefaultClient,
+	}
+}
+
+type launchpadBug struct {
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	Tags            []string `json:"tags"`
+	DateLastUpdated string   `json:"date_last_updated"`
+}
+
+func (c *LaunchpadIssueClient) GetIssue(id string) (*Issue, error) {
+	url := fmt.Sprintf("https://api.launchpad.net/devel/bugs/%s", id)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("launchpad: failed to fetch bug %s: %s", id, resp.Status)
+	}
+
+	var bug launchpadBug
+	if err := json.NewDecoder(resp.Body).Decode(&bug); err != nil {
+		return nil, err
+	}
+
+	return &Issue{
+		Prefix: "LP#",
+		ID:     id,
+		Title:  bug.Title,
+		Labels: bug.Tags,
+	}, nil
+}
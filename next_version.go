@@ -0,0 +1,42 @@
+package chglog
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var reSemVer = regexp.MustCompile(`^(.*?)(\d+)\.(\d+)\.(\d+)(-[0-9A-Za-z-.]+)?(\+[0-9A-Za-z-.]+)?$`)
+
+// ComputeNextTag derives the next tag for commits since currentTag,
+// using a versionCalculator configured by opts. The prefix and "v"
+// convention of currentTag (e.g. "frontend/v1.2.3") is preserved in the
+// returned tag. It is exposed as .NextTag in the template context
+// alongside .Versions, and backs the `chglog next-version` CLI command.
+func ComputeNextTag(commits []*Commit, currentTag string, opts *VersionCalculatorOptions) (string, error) {
+	bump := newVersionCalculator(opts).Calculate(commits)
+	if bump == NoBump {
+		return currentTag, nil
+	}
+
+	m := reSemVer.FindStringSubmatch(currentTag)
+	if m == nil {
+		return "", fmt.Errorf("chglog: %q is not a SemVer tag", currentTag)
+	}
+
+	prefix := m[1]
+	major, _ := strconv.Atoi(m[2])
+	minor, _ := strconv.Atoi(m[3])
+	patch, _ := strconv.Atoi(m[4])
+
+	switch bump {
+	case MajorBump:
+		major, minor, patch = major+1, 0, 0
+	case MinorBump:
+		minor, patch = minor+1, 0
+	case PatchBump:
+		patch++
+	}
+
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+}
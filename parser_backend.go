@@ -0,0 +1,35 @@
+package chglog
+
+import (
+	"path/filepath"
+
+	"github.com/tsuyoshiwada/go-gitcmd"
+)
+
+// commitParserBackend is implemented by both the exec-based commitParser
+// and the go-git-based gogitCommitParser, so callers can swap the
+// backend without caring which one produced a given *Commit slice.
+type commitParserBackend interface {
+	Parse(rev string) ([]*Commit, error)
+}
+
+// newParserBackend picks the commit-parsing backend according to
+// Config.Options.Backend. "gogit" walks the repository in-process via
+// go-git; anything else (including the empty default) falls back to
+// the exec-based `git log` / `git diff-tree` pipeline. Both backends
+// share the same on-disk commit cache unless Options.NoCache is set,
+// and both resolve issue refs against Config.Options.IssueTrackers.
+func newParserBackend(logger *Logger, client gitcmd.Client, repoPath string, jiraClient JiraClient, config *Config) (commitParserBackend, error) {
+	var cache *commitCache
+	if !config.Options.NoCache {
+		cache = newCommitCache(filepath.Join(repoPath, ".git"), cacheSchemaVersion(config.Options))
+	}
+
+	issueTrackers := config.Options.IssueTrackers
+
+	if config.Options.Backend == "gogit" {
+		return newGogitCommitParser(logger, repoPath, jiraClient, issueTrackers, cache, config)
+	}
+
+	return newCommitParser(logger, client, jiraClient, issueTrackers, cache, config), nil
+}
@@ -0,0 +1,110 @@
+package chglog
+
+import "strings"
+
+// VersionBump is the magnitude of a SemVer bump computed from a range
+// of conventional commits.
+type VersionBump int
+
+const (
+	// NoBump means the range contains only non-releasing commits
+	// (e.g. chore, docs, test) and no new version should be cut.
+	NoBump VersionBump = iota
+	PatchBump
+	MinorBump
+	MajorBump
+)
+
+// VersionCalculatorOptions configures how commits map to a VersionBump.
+type VersionCalculatorOptions struct {
+	// MinorTypes lists commit Types that trigger at least a minor
+	// bump. Defaults to []string{"feat"}.
+	MinorTypes []string
+
+	// NonReleasingTypes lists commit Types that never contribute to a
+	// bump on their own (e.g. "chore", "docs", "test"). Every other
+	// Type triggers at least a patch bump, so custom types (e.g. "ci",
+	// "build", "deps") bump by default unless explicitly excluded here.
+	// Defaults to []string{"chore", "docs", "test"}.
+	NonReleasingTypes []string
+
+	// BreakingChangeKeywords lists Note titles (matched
+	// case-insensitively) that force a major bump. Defaults to
+	// []string{"BREAKING CHANGE"}.
+	BreakingChangeKeywords []string
+}
+
+func (o *VersionCalculatorOptions) withDefaults() *VersionCalculatorOptions {
+	if o == nil {
+		o = &VersionCalculatorOptions{}
+	}
+	if len(o.MinorTypes) == 0 {
+		o.MinorTypes = []string{"feat"}
+	}
+	if len(o.NonReleasingTypes) == 0 {
+		o.NonReleasingTypes = []string{"chore", "docs", "test"}
+	}
+	if len(o.BreakingChangeKeywords) == 0 {
+		o.BreakingChangeKeywords = []string{"BREAKING CHANGE"}
+	}
+	return o
+}
+
+// versionCalculator derives the next SemVer bump from a set of commits
+// produced by commitExtractor.Extract.
+type versionCalculator struct {
+	opts *VersionCalculatorOptions
+}
+
+func newVersionCalculator(opts *VersionCalculatorOptions) *versionCalculator {
+	return &versionCalculator{
+		opts: opts.withDefaults(),
+	}
+}
+
+// Calculate returns the highest VersionBump implied by commits: MAJOR if
+// any commit is a breaking change, else MINOR if any commit's Type is in
+// MinorTypes, else PATCH unless every other commit's Type is in
+// NonReleasingTypes, otherwise NoBump.
+func (v *versionCalculator) Calculate(commits []*Commit) VersionBump {
+	bump := NoBump
+
+	for _, commit := range commits {
+		if v.isBreaking(commit) {
+			return MajorBump
+		}
+
+		if containsFold(v.opts.MinorTypes, commit.Type) && bump < MinorBump {
+			bump = MinorBump
+		} else if !containsFold(v.opts.NonReleasingTypes, commit.Type) && bump < PatchBump {
+			bump = PatchBump
+		}
+	}
+
+	return bump
+}
+
+func (v *versionCalculator) isBreaking(commit *Commit) bool {
+	if commit.Breaking {
+		return true
+	}
+
+	for _, note := range commit.Notes {
+		for _, keyword := range v.opts.BreakingChangeKeywords {
+			if strings.EqualFold(note.Title, keyword) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
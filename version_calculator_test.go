@@ -0,0 +1,88 @@
+package chglog
+
+import "testing"
+
+func TestVersionCalculatorCalculate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *VersionCalculatorOptions
+		commits []*Commit
+		want    VersionBump
+	}{
+		{
+			name:    "empty range",
+			commits: nil,
+			want:    NoBump,
+		},
+		{
+			name: "only non-releasing types",
+			commits: []*Commit{
+				{Type: "chore"},
+				{Type: "docs"},
+				{Type: "test"},
+			},
+			want: NoBump,
+		},
+		{
+			name: "unlisted type defaults to patch",
+			commits: []*Commit{
+				{Type: "fix"},
+			},
+			want: PatchBump,
+		},
+		{
+			name: "custom type bumps by default unless excluded",
+			commits: []*Commit{
+				{Type: "ci"},
+			},
+			want: PatchBump,
+		},
+		{
+			name: "feat bumps minor",
+			commits: []*Commit{
+				{Type: "chore"},
+				{Type: "feat"},
+			},
+			want: MinorBump,
+		},
+		{
+			name: "Breaking field forces major over feat",
+			commits: []*Commit{
+				{Type: "feat"},
+				{Type: "fix", Breaking: true},
+			},
+			want: MajorBump,
+		},
+		{
+			name: "BREAKING CHANGE note forces major",
+			commits: []*Commit{
+				{Type: "fix", Notes: []*Note{{Title: "BREAKING CHANGE", Body: "..."}}},
+			},
+			want: MajorBump,
+		},
+		{
+			name: "note title match is case-insensitive",
+			commits: []*Commit{
+				{Type: "fix", Notes: []*Note{{Title: "breaking change", Body: "..."}}},
+			},
+			want: MajorBump,
+		},
+		{
+			name: "custom NonReleasingTypes overrides the default deny-list",
+			opts: &VersionCalculatorOptions{NonReleasingTypes: []string{"chore"}},
+			commits: []*Commit{
+				{Type: "docs"},
+			},
+			want: PatchBump,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newVersionCalculator(tt.opts)
+			if got := v.Calculate(tt.commits); got != tt.want {
+				t.Errorf("Calculate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}